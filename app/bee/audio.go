@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const sampleRate = 44100
+
+// sfxPoolSize is the number of preloaded players kept per sound effect so
+// overlapping hits don't cut each other off.
+const sfxPoolSize = 8
+
+// soundPool is a small ring of preloaded *audio.Player instances sharing the
+// same decoded PCM data. Playing a sound rewinds and plays the next free
+// player rather than reusing a single one, so rapid, overlapping triggers
+// (e.g. clicking several bees in a row) all get heard. When every player
+// is busy, the one after the last played is cut off and restarted.
+type soundPool struct {
+	players []*audio.Player
+	next    int
+}
+
+func newSoundPool(ctx *audio.Context, path string, size int) *soundPool {
+	data := decodeWAV(ctx, path)
+
+	pool := &soundPool{players: make([]*audio.Player, size)}
+	for i := range pool.players {
+		p, err := audio.NewPlayer(ctx, bytes.NewReader(data))
+		if err != nil {
+			log.Fatalf("failed to create player for %s: %v", path, err)
+		}
+		pool.players[i] = p
+	}
+	return pool
+}
+
+func (p *soundPool) play(volume float64) {
+	idx := p.next
+	for i := range p.players {
+		j := (p.next + i) % len(p.players)
+		if !p.players[j].IsPlaying() {
+			idx = j
+			break
+		}
+	}
+	player := p.players[idx]
+	p.next = (idx + 1) % len(p.players)
+
+	player.Pause()
+	player.Rewind()
+	player.SetVolume(volume)
+	player.Play()
+}
+
+// decodeWAV fully decodes path into PCM bytes so the result can back
+// multiple independent audio.Player instances without re-reading the file.
+func decodeWAV(ctx *audio.Context, path string) []byte {
+	f, err := ebitenutil.OpenFile(path)
+	if err != nil {
+		log.Fatalf("failed to open sound %s: %v", path, err)
+	}
+	defer f.Close()
+
+	stream, err := wav.DecodeWithSampleRate(sampleRate, f)
+	if err != nil {
+		log.Fatalf("failed to decode sound %s: %v", path, err)
+	}
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		log.Fatalf("failed to read sound %s: %v", path, err)
+	}
+	return data
+}
+
+// audioSystem owns the shared audio.Context, the looping background music,
+// and the SFX pools triggered from Game.Update.
+type audioSystem struct {
+	context *audio.Context
+	music   *audio.Player
+	catch   *soundPool
+	sting   *soundPool
+	over    *soundPool
+	volume  float64
+}
+
+// newAudioSystem loads music and sound effects from the sound/ directory.
+func newAudioSystem() *audioSystem {
+	ctx := audio.NewContext(sampleRate)
+
+	musicFile, err := ebitenutil.OpenFile("sound/music.ogg")
+	if err != nil {
+		log.Fatalf("failed to open music: %v", err)
+	}
+	musicStream, err := vorbis.DecodeWithSampleRate(sampleRate, musicFile)
+	if err != nil {
+		log.Fatalf("failed to decode music: %v", err)
+	}
+	loop := audio.NewInfiniteLoop(musicStream, musicStream.Length())
+	music, err := audio.NewPlayer(ctx, loop)
+	if err != nil {
+		log.Fatalf("failed to create music player: %v", err)
+	}
+
+	return &audioSystem{
+		context: ctx,
+		music:   music,
+		catch:   newSoundPool(ctx, "sound/catch.wav", sfxPoolSize),
+		sting:   newSoundPool(ctx, "sound/sting.wav", sfxPoolSize),
+		over:    newSoundPool(ctx, "sound/gameover.wav", 1),
+		volume:  1,
+	}
+}
+
+// SetVolume clamps and applies volume to the music player; SFX pools read
+// the stored volume the next time they play.
+func (a *audioSystem) SetVolume(volume float64) {
+	if volume < 0 {
+		volume = 0
+	}
+	if volume > 1 {
+		volume = 1
+	}
+	a.volume = volume
+	a.music.SetVolume(volume)
+}
+
+func (a *audioSystem) startMusic() {
+	if !a.music.IsPlaying() {
+		a.music.Play()
+	}
+}
+
+func (a *audioSystem) stopMusic() {
+	a.music.Pause()
+	a.music.Rewind()
+}
+
+func (a *audioSystem) playCatch()    { a.catch.play(a.volume) }
+func (a *audioSystem) playSting()    { a.sting.play(a.volume) }
+func (a *audioSystem) playGameOver() { a.over.play(a.volume) }
@@ -0,0 +1,153 @@
+// Package component holds the plain data types attached to entities, and
+// the World that stores them. Behavior lives in package system, not here.
+package component
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Entity is an opaque handle into a World's component maps.
+type Entity int
+
+// Position is an entity's location in screen space.
+type Position struct {
+	X, Y float64
+}
+
+// Velocity is an entity's per-tick movement.
+type Velocity struct {
+	X, Y float64
+}
+
+// SpriteSheet slices a source image into a grid of frames addressed by
+// (col, row): row selects a pose, column selects the frame within that
+// pose's animation loop.
+type SpriteSheet struct {
+	Image  *ebiten.Image
+	FrameW int
+	FrameH int
+	Cols   int
+	Rows   int
+}
+
+// NewSpriteSheet slices img into frameW x frameH frames.
+func NewSpriteSheet(img *ebiten.Image, frameW, frameH int) *SpriteSheet {
+	w, h := img.Size()
+	return &SpriteSheet{
+		Image:  img,
+		FrameW: frameW,
+		FrameH: frameH,
+		Cols:   w / frameW,
+		Rows:   h / frameH,
+	}
+}
+
+// At returns the subimage for the frame at (col, row).
+func (s *SpriteSheet) At(col, row int) *ebiten.Image {
+	x0, y0 := col*s.FrameW, row*s.FrameH
+	rect := image.Rect(x0, y0, x0+s.FrameW, y0+s.FrameH)
+	return s.Image.SubImage(rect).(*ebiten.Image)
+}
+
+// FrameCount is the number of animation frames on a row.
+func (s *SpriteSheet) FrameCount() int {
+	return s.Cols
+}
+
+// Sprite is the animated visual for an entity.
+type Sprite struct {
+	Sheet         *SpriteSheet
+	Frame         int
+	Tick          int
+	TicksPerFrame int
+	FacingLeft    bool
+}
+
+// Hitbox is the clickable/collidable bounds of an entity, anchored at its
+// Position.
+type Hitbox struct {
+	Width, Height int
+}
+
+// Clickable marks an entity as eligible for ClickSystem to pick up pointer
+// clicks. It carries no data; presence in the World is the signal.
+type Clickable struct{}
+
+// Score is the number of points awarded when this entity is caught.
+type Score struct {
+	Value int
+}
+
+// Wave carries the parameters of a sinusoidal flight path (used by the
+// Bat enemy): the entity oscillates Amplitude pixels above and below BaseY
+// as Phase advances by Speed each tick.
+type Wave struct {
+	BaseY     float64
+	Amplitude float64
+	Phase     float64
+	Speed     float64
+}
+
+// World owns every entity's components. Systems read and write it each
+// tick rather than entities owning their own state.
+type World struct {
+	nextID Entity
+
+	Positions  map[Entity]*Position
+	Velocities map[Entity]*Velocity
+	Sprites    map[Entity]*Sprite
+	Hitboxes   map[Entity]*Hitbox
+	Clickables map[Entity]*Clickable
+	Scores     map[Entity]*Score
+	Waves      map[Entity]*Wave
+
+	// Tags records a free-form entity kind ("bee", "hornet", "queen",
+	// "bat", ...) for systems that still need to branch on what an
+	// entity is.
+	Tags map[Entity]string
+}
+
+// NewWorld returns an empty World ready to have entities added to it.
+func NewWorld() *World {
+	return &World{
+		Positions:  make(map[Entity]*Position),
+		Velocities: make(map[Entity]*Velocity),
+		Sprites:    make(map[Entity]*Sprite),
+		Hitboxes:   make(map[Entity]*Hitbox),
+		Clickables: make(map[Entity]*Clickable),
+		Scores:     make(map[Entity]*Score),
+		Waves:      make(map[Entity]*Wave),
+		Tags:       make(map[Entity]string),
+	}
+}
+
+// NewEntity allocates and returns a fresh Entity with no components.
+func (w *World) NewEntity() Entity {
+	w.nextID++
+	return w.nextID
+}
+
+// Destroy removes every component belonging to e.
+func (w *World) Destroy(e Entity) {
+	delete(w.Positions, e)
+	delete(w.Velocities, e)
+	delete(w.Sprites, e)
+	delete(w.Hitboxes, e)
+	delete(w.Clickables, e)
+	delete(w.Scores, e)
+	delete(w.Waves, e)
+	delete(w.Tags, e)
+}
+
+// Entities returns every live entity, i.e. everything with a Position.
+// Position is the component every entity in this game carries, so it
+// stands in for a "does this entity still exist" check.
+func (w *World) Entities() []Entity {
+	entities := make([]Entity, 0, len(w.Positions))
+	for e := range w.Positions {
+		entities = append(entities, e)
+	}
+	return entities
+}
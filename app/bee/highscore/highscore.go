@@ -0,0 +1,108 @@
+// Package highscore persists the top scores for the bee-catching game to a
+// small JSON file under the user's config directory, so a leaderboard
+// survives across runs without any server.
+package highscore
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// maxEntries caps how many scores the table keeps; lower scores fall off
+// the bottom as better ones are added.
+const maxEntries = 10
+
+// Entry records one completed playthrough that made the table.
+type Entry struct {
+	Initials string  `json:"initials"`
+	Score    int     `json:"score"`
+	Hornets  int     `json:"hornets"`
+	Level    int     `json:"level"`
+	Seed     uint64  `json:"seed"`
+	Duration float64 `json:"duration_seconds"`
+	Date     string  `json:"date"`
+}
+
+// Table is the on-disk leaderboard, sorted by Score descending.
+type Table struct {
+	Entries []Entry `json:"entries"`
+}
+
+// path returns the scores file's location, creating no directories itself.
+func path() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aws-q", "scores.json"), nil
+}
+
+// Load reads the leaderboard from disk. A missing file is not an error; it
+// just means no scores have been recorded yet.
+func Load() (*Table, error) {
+	p, err := path()
+	if err != nil {
+		return &Table{}, err
+	}
+
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return &Table{}, nil
+	}
+	if err != nil {
+		return &Table{}, err
+	}
+
+	var t Table
+	if err := json.Unmarshal(data, &t); err != nil {
+		return &Table{}, err
+	}
+	return &t, nil
+}
+
+// Save writes the leaderboard to disk, creating its directory if needed.
+func (t *Table) Save() error {
+	p, err := path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0o644)
+}
+
+// Qualifies reports whether score would earn a spot in the table. A
+// non-positive score never qualifies, even on an empty table, since it
+// isn't a result worth immortalizing.
+func (t *Table) Qualifies(score int) bool {
+	if score <= 0 {
+		return false
+	}
+	if len(t.Entries) < maxEntries {
+		return true
+	}
+	for _, e := range t.Entries {
+		if score > e.Score {
+			return true
+		}
+	}
+	return false
+}
+
+// Add inserts e, keeping Entries sorted by Score descending and trimmed to
+// maxEntries.
+func (t *Table) Add(e Entry) {
+	t.Entries = append(t.Entries, e)
+	sort.Slice(t.Entries, func(i, j int) bool { return t.Entries[i].Score > t.Entries[j].Score })
+	if len(t.Entries) > maxEntries {
+		t.Entries = t.Entries[:maxEntries]
+	}
+}
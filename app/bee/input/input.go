@@ -0,0 +1,180 @@
+// Package input turns ebiten's per-frame mouse, touch and gamepad polling
+// into a queue of pointer events, so callers (ClickSystem) consume
+// discrete Down/Move/Up events instead of polling globals directly. That
+// in turn lets multiple simultaneous touches each resolve independently
+// in the same tick.
+package input
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// PointerID identifies one contact (a mouse, a finger, or the gamepad's
+// virtual cursor) across its Down/Move/Up events.
+type PointerID int
+
+const (
+	mousePointerID   PointerID = -1
+	gamepadPointerID PointerID = -2
+)
+
+// Event is implemented by PointerDownEvent, PointerMoveEvent and
+// PointerUpEvent. The unexported method keeps it a closed set.
+type Event interface {
+	pointerID() PointerID
+}
+
+// PointerDownEvent fires the tick a pointer first contacts the screen.
+type PointerDownEvent struct {
+	X, Y float64
+	ID   PointerID
+}
+
+// PointerMoveEvent fires on every tick a pointer stays down after its
+// Down event, carrying its current position whether or not it moved.
+type PointerMoveEvent struct {
+	X, Y float64
+	ID   PointerID
+}
+
+// PointerUpEvent fires the tick a pointer is released.
+type PointerUpEvent struct {
+	ID PointerID
+}
+
+func (e PointerDownEvent) pointerID() PointerID { return e.ID }
+func (e PointerMoveEvent) pointerID() PointerID { return e.ID }
+func (e PointerUpEvent) pointerID() PointerID   { return e.ID }
+
+const (
+	gamepadStickDeadzone = 0.2
+	gamepadCursorSpeed   = 8.0
+)
+
+// Source polls ebiten once per tick and reports what changed since the
+// last Poll.
+type Source struct {
+	mouseDown bool
+	touchDown map[PointerID]bool
+
+	gamepadX, gamepadY float64
+	gamepadDown        bool
+	gamepadInitialized bool
+}
+
+// NewSource returns a Source with no pointers currently down.
+func NewSource() *Source {
+	return &Source{touchDown: make(map[PointerID]bool)}
+}
+
+// Poll returns every pointer event generated since the previous Poll call.
+func (s *Source) Poll(screenWidth, screenHeight int) []Event {
+	var events []Event
+	events = append(events, s.pollMouse()...)
+	events = append(events, s.pollTouches()...)
+	events = append(events, s.pollGamepad(screenWidth, screenHeight)...)
+	return events
+}
+
+func (s *Source) pollMouse() []Event {
+	x, y := ebiten.CursorPosition()
+
+	switch {
+	case inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft):
+		s.mouseDown = true
+		return []Event{PointerDownEvent{X: float64(x), Y: float64(y), ID: mousePointerID}}
+	case inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft):
+		s.mouseDown = false
+		return []Event{PointerUpEvent{ID: mousePointerID}}
+	case s.mouseDown:
+		return []Event{PointerMoveEvent{X: float64(x), Y: float64(y), ID: mousePointerID}}
+	}
+	return nil
+}
+
+func (s *Source) pollTouches() []Event {
+	var events []Event
+
+	active := ebiten.AppendTouchIDs(nil)
+	seen := make(map[PointerID]bool, len(active))
+
+	for _, id := range active {
+		pid := PointerID(id)
+		seen[pid] = true
+
+		x, y := ebiten.TouchPosition(id)
+		if s.touchDown[pid] {
+			events = append(events, PointerMoveEvent{X: float64(x), Y: float64(y), ID: pid})
+			continue
+		}
+		s.touchDown[pid] = true
+		events = append(events, PointerDownEvent{X: float64(x), Y: float64(y), ID: pid})
+	}
+
+	for pid := range s.touchDown {
+		if seen[pid] {
+			continue
+		}
+		delete(s.touchDown, pid)
+		events = append(events, PointerUpEvent{ID: pid})
+	}
+
+	return events
+}
+
+// pollGamepad drives a virtual cursor from the first gamepad's right
+// stick, clicking with the standard layout's bottom face button (A on an
+// Xbox-style pad).
+func (s *Source) pollGamepad(screenWidth, screenHeight int) []Event {
+	ids := ebiten.AppendGamepadIDs(nil)
+	if len(ids) == 0 {
+		return nil
+	}
+	id := ids[0]
+	if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+		return nil
+	}
+
+	if !s.gamepadInitialized {
+		s.gamepadX = float64(screenWidth) / 2
+		s.gamepadY = float64(screenHeight) / 2
+		s.gamepadInitialized = true
+	}
+
+	axisX := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisRightStickHorizontal)
+	axisY := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisRightStickVertical)
+	if math.Abs(axisX) > gamepadStickDeadzone {
+		s.gamepadX += axisX * gamepadCursorSpeed
+	}
+	if math.Abs(axisY) > gamepadStickDeadzone {
+		s.gamepadY += axisY * gamepadCursorSpeed
+	}
+	s.gamepadX = clamp(s.gamepadX, 0, float64(screenWidth))
+	s.gamepadY = clamp(s.gamepadY, 0, float64(screenHeight))
+
+	pressed := ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonRightBottom)
+	switch {
+	case pressed && !s.gamepadDown:
+		s.gamepadDown = true
+		return []Event{PointerDownEvent{X: s.gamepadX, Y: s.gamepadY, ID: gamepadPointerID}}
+	case !pressed && s.gamepadDown:
+		s.gamepadDown = false
+		return []Event{PointerUpEvent{ID: gamepadPointerID}}
+	case s.gamepadDown:
+		return []Event{PointerMoveEvent{X: s.gamepadX, Y: s.gamepadY, ID: gamepadPointerID}}
+	}
+	return nil
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,64 @@
+package main
+
+// Level describes one wave's duration, difficulty and enemy mix.
+type Level struct {
+	Name            string
+	Duration        int // seconds
+	MaxBees         int
+	SpawnRate       float64
+	HornetChance    float64
+	HighSpeedChance float64
+	BatChance       float64
+	// Threshold is the score the player must reach to advance out of the
+	// *previous* level into this one; the first level's is unused.
+	Threshold int
+	// Enabled lists which kinds this level spawns: "bee", "hornet",
+	// "bat", "queen". "bee" should always be present.
+	Enabled []string
+}
+
+// levels is the game's fixed difficulty curve. Scores thresholds are
+// cumulative: reaching levels[i].Threshold total points advances the
+// player into level i.
+var levels = []Level{
+	{
+		Name:            "Level 1",
+		Duration:        gameTime,
+		MaxBees:         10,
+		SpawnRate:       0.05,
+		HornetChance:    0.2,
+		HighSpeedChance: 0.1,
+		Enabled:         []string{"bee", "hornet"},
+	},
+	{
+		Name:            "Level 2 — Beware the swarm!",
+		Duration:        60,
+		MaxBees:         14,
+		SpawnRate:       0.08,
+		HornetChance:    0.25,
+		HighSpeedChance: 0.15,
+		BatChance:       0.1,
+		Threshold:       15,
+		Enabled:         []string{"bee", "hornet", "bat"},
+	},
+	{
+		Name:            "Level 3 — The Queen awakens",
+		Duration:        60,
+		MaxBees:         16,
+		SpawnRate:       0.08,
+		HornetChance:    0.3,
+		HighSpeedChance: 0.2,
+		BatChance:       0.15,
+		Threshold:       35,
+		Enabled:         []string{"bee", "hornet", "bat", "queen"},
+	},
+}
+
+// enabledSet turns a Level's Enabled slice into the map SpawnSystem wants.
+func enabledSet(kinds []string) map[string]bool {
+	set := make(map[string]bool, len(kinds))
+	for _, k := range kinds {
+		set[k] = true
+	}
+	return set
+}
@@ -2,118 +2,227 @@ package main
 
 import (
 	"fmt"
-	"image"
 	"image/color"
-	_ "image/jpeg"
-	_ "image/png"
 	"log"
-	"math/rand"
+	"math/rand/v2"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/basicfont"
+
+	"github.com/kf-pineapple/aws-q/app/bee/component"
+	"github.com/kf-pineapple/aws-q/app/bee/highscore"
+	"github.com/kf-pineapple/aws-q/app/bee/input"
+	"github.com/kf-pineapple/aws-q/app/bee/system"
 )
 
 const (
 	screenWidth  = 800
 	screenHeight = 600
 	gameTime     = 60 // Game time in seconds
-)
 
-// Bee represents a bee in the game
-type Bee struct {
-	x, y        float64
-	speedX      float64
-	speedY      float64
-	width       int
-	height      int
-	isHornets   bool
-	isHighSpeed bool
-	visible     bool
-}
+	maxInitialsLen = 3
+)
 
-// Game represents the game state
+// Game represents the game state. Per-entity simulation (movement,
+// spawning, clicks, rendering, HUD, the lightning flash and the game-over
+// check) is delegated to the systems in package system; Game itself only
+// owns the things that don't belong to any one entity: the title/playing/
+// game-over flow, the countdown clock, and audio.
 type Game struct {
-	bees            []Bee
-	score           int
-	hornetsClicked  int
-	gameOver        bool
-	gameStarted     bool
-	startTime       time.Time
-	remainingTime   int
-	lightningEffect bool
-	lightningTimer  int
-	face            font.Face
+	world    *component.World
+	ctx      *system.Context
+	update   []system.UpdateSystem
+	draw     []system.DrawSystem
+	spawn    *system.SpawnSystem
+	behavior *system.BehaviorSystem
+
+	gameStarted bool
+	startTime   time.Time
+
+	currentLevel   int
+	levelDuration  int
+	levelCardText  string
+	levelCardTicks int
+
+	face        font.Face
+	audio       *audioSystem
+	audioVolume float64
+
+	rng      *rand.Rand
+	inputSrc *input.Source
+
+	seed         uint64
+	sessionStart time.Time
+
+	highScores     *highscore.Table
+	showHighScores bool
+	wasGameOver    bool
+	enteringScore  bool
+	initialsInput  string
+	pendingEntry   highscore.Entry
 }
 
-// Initialize a new game
+// NewGame starts a game seeded from the current time, so every playthrough
+// spawns and moves bees differently.
 func NewGame() *Game {
-	g := &Game{
-		bees:           make([]Bee, 0),
-		score:          0,
-		hornetsClicked: 0,
-		gameOver:       false,
-		gameStarted:    false,
-		remainingTime:  gameTime,
-		face:           basicfont.Face7x13,
+	return NewGameWithSeed(uint64(time.Now().UnixNano()))
+}
+
+// NewGameWithSeed starts a game whose spawn and velocity rolls are
+// reproducible from seed, enabling deterministic playtesting and replays.
+// This covers dice rolls only: click resolution and draw order iterate
+// component.World's maps, and LightningEffectSystem.Draw rolls bolt
+// positions from its own unseeded source, so none of that is part of the
+// replay contract (see system.Context.Rand).
+func NewGameWithSeed(seed uint64) *Game {
+	loadSpriteSheets()
+	loadForestImage()
+
+	scores, err := highscore.Load()
+	if err != nil {
+		log.Printf("failed to load high scores: %v", err)
 	}
 
-	// Load bee images
-	loadBeeImages()
+	g := &Game{
+		face:        basicfont.Face7x13,
+		audio:       newAudioSystem(),
+		audioVolume: 1,
+		rng:         rand.New(rand.NewPCG(seed, ^seed)),
+		inputSrc:    input.NewSource(),
+		seed:        seed,
+		highScores:  scores,
+	}
+	g.audio.SetVolume(g.audioVolume)
+	g.resetRound()
 
 	return g
 }
 
-var (
-	beeImage     *ebiten.Image
-	hornetsImage *ebiten.Image
-	forestImage  *ebiten.Image
-)
+// resetRound clears the world and round state for a fresh game, without
+// touching the things that persist across games (audio, the face, window
+// setup).
+func (g *Game) resetRound() {
+	g.world = component.NewWorld()
+	g.currentLevel = 0
+	g.levelCardTicks = 0
+
+	g.wasGameOver = false
+	g.enteringScore = false
+	g.initialsInput = ""
+
+	g.ctx = &system.Context{
+		World:        g.world,
+		ScreenWidth:  screenWidth,
+		ScreenHeight: screenHeight,
+		Rand:         g.rng,
+		Face:         g.face,
+		OnCatch:      g.audio.playCatch,
+		OnSting:      g.audio.playSting,
+		OnGameOver: func() {
+			g.audio.stopMusic()
+			g.audio.playGameOver()
+		},
+	}
 
-// Load bee images from local files
-func loadBeeImages() {
-	// Load bee image
-	beeFile, err := ebitenutil.OpenFile("image/bee.png")
-	if err != nil {
-		log.Fatalf("Failed to open bee image: %v", err)
+	g.behavior = system.NewBehaviorSystem()
+	g.spawn = system.NewSpawnSystem(beeSheet, hornetSheet, queenSheet, batSheet, animTicksPerFrame, g.behavior)
+	g.applyLevel(levels[0])
+
+	lightning := system.NewLightningEffectSystem()
+
+	g.update = []system.UpdateSystem{
+		system.NewGameOverSystem(),
+		g.spawn,
+		g.behavior,
+		lightning,
+		system.NewMovementSystem(g.behavior),
+		system.NewClickSystem(g.behavior),
+	}
+	g.draw = []system.DrawSystem{
+		system.NewRenderSystem(),
+		lightning,
+		system.NewHUDSystem(),
 	}
-	defer beeFile.Close()
+}
 
-	img, _, err := image.Decode(beeFile)
-	if err != nil {
-		log.Fatalf("Failed to decode bee image: %v", err)
+// applyLevel retunes SpawnSystem to lvl and resets the round clock to its
+// Duration, so each level is a fresh timed wave rather than a 60-second cap
+// on the whole game.
+func (g *Game) applyLevel(lvl Level) {
+	g.spawn.SpawnChance = lvl.SpawnRate
+	g.spawn.MaxBees = lvl.MaxBees
+	g.spawn.HornetChance = lvl.HornetChance
+	g.spawn.HighSpeedChance = lvl.HighSpeedChance
+	g.spawn.BatChance = lvl.BatChance
+	g.spawn.Enabled = enabledSet(lvl.Enabled)
+	g.spawn.ResetForLevel()
+
+	g.levelDuration = lvl.Duration
+	g.ctx.RemainingTime = lvl.Duration
+	g.startTime = time.Now()
+}
+
+// maybeAdvanceLevel moves to the next level once the score threshold is
+// met, showing a between-level card for a couple of seconds.
+func (g *Game) maybeAdvanceLevel() {
+	next := g.currentLevel + 1
+	if next >= len(levels) || g.ctx.Score < levels[next].Threshold {
+		return
 	}
-	beeImage = ebiten.NewImageFromImage(img)
 
-	// Load hornets image
-	hornetsFile, err := ebitenutil.OpenFile("image/hornet.png")
-	if err != nil {
-		log.Fatalf("Failed to open hornets image: %v", err)
+	g.currentLevel = next
+	g.applyLevel(levels[next])
+	g.levelCardText = levels[next].Name
+	g.levelCardTicks = 120 // ~2 seconds at 60 TPS
+}
+
+// onGameOver fires once, on the first Update that sees GameOver true. If the
+// round's score makes the table, it queues the initials-entry prompt;
+// otherwise there's nothing more to do.
+func (g *Game) onGameOver() {
+	g.pendingEntry = highscore.Entry{
+		Score:    g.ctx.Score,
+		Hornets:  g.ctx.HornetsClicked,
+		Level:    g.currentLevel + 1,
+		Seed:     g.seed,
+		Duration: time.Since(g.sessionStart).Seconds(),
+		Date:     time.Now().Format("2006-01-02"),
 	}
-	defer hornetsFile.Close()
+	g.enteringScore = g.highScores.Qualifies(g.pendingEntry.Score)
+}
 
-	img, _, err = image.Decode(hornetsFile)
-	if err != nil {
-		log.Fatalf("Failed to decode hornets image: %v", err)
+// updateInitialsEntry reads keyboard input for the initials prompt (up to
+// 3 letters), finalizing and saving the entry on Enter once at least one
+// letter has been typed.
+func (g *Game) updateInitialsEntry() {
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if len(g.initialsInput) >= maxInitialsLen {
+			break
+		}
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		if r >= 'A' && r <= 'Z' {
+			g.initialsInput += string(r)
+		}
 	}
-	hornetsImage = ebiten.NewImageFromImage(img)
 
-	// Load forest background
-	forestFile, err := ebitenutil.OpenFile("image/forest.jpg")
-	if err != nil {
-		log.Fatalf("Failed to open forest image: %v", err)
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.initialsInput) > 0 {
+		g.initialsInput = g.initialsInput[:len(g.initialsInput)-1]
 	}
-	defer forestFile.Close()
 
-	img, _, err = image.Decode(forestFile)
-	if err != nil {
-		log.Fatalf("Failed to decode forest image: %v", err)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) && len(g.initialsInput) > 0 {
+		g.pendingEntry.Initials = g.initialsInput
+		g.highScores.Add(g.pendingEntry)
+		if err := g.highScores.Save(); err != nil {
+			log.Printf("failed to save high scores: %v", err)
+		}
+		g.enteringScore = false
 	}
-	forestImage = ebiten.NewImageFromImage(img)
 }
 
 // Draw forest background
@@ -135,150 +244,109 @@ func drawForestBackground(screen *ebiten.Image) {
 	screen.DrawImage(overlayImage, nil)
 }
 
-// Add a new bee to the game
-func (g *Game) addBee() {
-	isHornets := rand.Float64() < 0.2   // 20% chance to be a hornet
-	isHighSpeed := rand.Float64() < 0.1 // 10% chance to be high speed
-
-	var img *ebiten.Image
-	if isHornets {
-		img = hornetsImage
-	} else {
-		img = beeImage
-	}
-
-	width, height := img.Size()
-	width = width / 2 // Make the hitbox smaller than the actual image
-	height = height / 2
-
-	speedBase := 2.0
-	if isHighSpeed {
-		speedBase = 5.0
+// Update the game state
+func (g *Game) Update() error {
+	// Poll every tick regardless of game phase, so Source's touch/mouse
+	// down-tracking doesn't miss a release that happens on, say, the
+	// start screen.
+	events := g.inputSrc.Poll(screenWidth, screenHeight)
+
+	// Volume controls work on any screen.
+	if inpututil.IsKeyJustPressed(ebiten.KeyMinus) {
+		g.audio.SetVolume(g.audioVolume - 0.1)
+		g.audioVolume = g.audio.volume
 	}
-
-	bee := Bee{
-		x:           float64(rand.Intn(screenWidth - width)),
-		y:           float64(rand.Intn(screenHeight - height)),
-		speedX:      (rand.Float64()*2 - 1) * speedBase,
-		speedY:      (rand.Float64()*2 - 1) * speedBase,
-		width:       width,
-		height:      height,
-		isHornets:   isHornets,
-		isHighSpeed: isHighSpeed,
-		visible:     true,
+	if inpututil.IsKeyJustPressed(ebiten.KeyEqual) {
+		g.audio.SetVolume(g.audioVolume + 0.1)
+		g.audioVolume = g.audio.volume
 	}
 
-	g.bees = append(g.bees, bee)
-}
-
-// Update the game state
-func (g *Game) Update() error {
 	if !g.gameStarted {
-		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		if inpututil.IsKeyJustPressed(ebiten.KeyH) {
+			g.showHighScores = !g.showHighScores
+		}
+		if !g.showHighScores && inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 			g.gameStarted = true
+			g.sessionStart = time.Now()
 			g.startTime = time.Now()
 		}
 		return nil
 	}
 
-	if g.gameOver {
+	if g.ctx.GameOver {
+		if !g.wasGameOver {
+			g.wasGameOver = true
+			g.onGameOver()
+		}
+
+		if g.enteringScore {
+			g.updateInitialsEntry()
+			return nil
+		}
+
 		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
-			// Reset game
-			g.bees = make([]Bee, 0)
-			g.score = 0
-			g.hornetsClicked = 0
-			g.gameOver = false
+			g.resetRound()
+			g.sessionStart = time.Now()
 			g.startTime = time.Now()
-			g.remainingTime = gameTime
-			g.lightningEffect = false
 		}
 		return nil
 	}
 
-	// Update remaining time
-	elapsed := time.Since(g.startTime)
-	g.remainingTime = gameTime - int(elapsed.Seconds())
-	if g.remainingTime <= 0 {
-		g.gameOver = true
-		g.remainingTime = 0
-		return nil
-	}
+	g.audio.startMusic()
 
-	// Add new bees randomly
-	if rand.Float64() < 0.05 && len(g.bees) < 10 {
-		g.addBee()
+	if g.levelCardTicks > 0 {
+		g.levelCardTicks--
+		return nil
 	}
 
-	// Update lightning effect timer
-	if g.lightningEffect {
-		g.lightningTimer--
-		if g.lightningTimer <= 0 {
-			g.lightningEffect = false
-		}
+	// Update remaining time
+	elapsed := time.Since(g.startTime)
+	g.ctx.RemainingTime = g.levelDuration - int(elapsed.Seconds())
+	if g.ctx.RemainingTime < 0 {
+		g.ctx.RemainingTime = 0
 	}
 
-	// Update bee positions
-	for i := range g.bees {
-		if !g.bees[i].visible {
-			continue
-		}
-
-		g.bees[i].x += g.bees[i].speedX
-		g.bees[i].y += g.bees[i].speedY
+	g.ctx.CursorX, g.ctx.CursorY = ebiten.CursorPosition()
+	g.ctx.Events = events
 
-		// Bounce off walls
-		if g.bees[i].x <= 0 || g.bees[i].x >= float64(screenWidth-g.bees[i].width) {
-			g.bees[i].speedX = -g.bees[i].speedX
+	for _, s := range g.update {
+		if g.ctx.GameOver {
+			break
 		}
-		if g.bees[i].y <= 0 || g.bees[i].y >= float64(screenHeight-g.bees[i].height) {
-			g.bees[i].speedY = -g.bees[i].speedY
+		if err := s.Update(g.ctx); err != nil {
+			return err
 		}
 	}
 
-	// Check for mouse clicks
-	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
-		x, y := ebiten.CursorPosition()
-		for i := range g.bees {
-			if !g.bees[i].visible {
-				continue
-			}
-
-			// Check if click is within bee bounds
-			if float64(x) >= g.bees[i].x && float64(x) <= g.bees[i].x+float64(g.bees[i].width) &&
-				float64(y) >= g.bees[i].y && float64(y) <= g.bees[i].y+float64(g.bees[i].height) {
-
-				g.bees[i].visible = false
-
-				if g.bees[i].isHornets {
-					g.hornetsClicked++
-					g.lightningEffect = true
-					g.lightningTimer = 30 // Show lightning for 30 frames
-					if g.hornetsClicked >= 3 {
-						g.gameOver = true
-					}
-				} else {
-					// Add score based on bee type
-					if g.bees[i].isHighSpeed {
-						g.score += 3 // High-speed bees worth more points
-					} else {
-						g.score++
-					}
-				}
-				break
-			}
-		}
+	if !g.ctx.GameOver {
+		g.maybeAdvanceLevel()
 	}
 
-	// Remove invisible bees
-	newBees := make([]Bee, 0)
-	for _, bee := range g.bees {
-		if bee.visible {
-			newBees = append(newBees, bee)
-		}
+	return nil
+}
+
+// drawCentered draws msg horizontally centered at y, with a drop shadow so
+// it reads against the forest background.
+func (g *Game) drawCentered(screen *ebiten.Image, msg string, y int) {
+	x := (screenWidth - len(msg)*7) / 2
+	text.Draw(screen, msg, g.face, x+1, y+1, color.Black)
+	text.Draw(screen, msg, g.face, x, y, color.White)
+}
+
+// drawHighScores lists the top entries starting at y, one per line.
+func (g *Game) drawHighScores(screen *ebiten.Image, y int) {
+	g.drawCentered(screen, "High Scores", y)
+	y += 20
+
+	if len(g.highScores.Entries) == 0 {
+		g.drawCentered(screen, "(none yet)", y)
+		return
 	}
-	g.bees = newBees
 
-	return nil
+	for i, e := range g.highScores.Entries {
+		line := fmt.Sprintf("%2d. %-3s %5d pts  Lv%d  %s", i+1, e.Initials, e.Score, e.Level, e.Date)
+		g.drawCentered(screen, line, y+20*i)
+	}
 }
 
 // Draw the game
@@ -287,74 +355,41 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	drawForestBackground(screen)
 
 	if !g.gameStarted {
-		// Draw start screen
-		msg := "Click to start the Bee Catching Game!"
-		x := (screenWidth - len(msg)*7) / 2
-		y := screenHeight / 2
-
-		// Draw text with shadow for better visibility against forest background
-		text.Draw(screen, msg, g.face, x+1, y+1, color.Black)
-		text.Draw(screen, msg, g.face, x, y, color.White)
-		return
-	}
+		if g.showHighScores {
+			g.drawHighScores(screen, 120)
+			g.drawCentered(screen, "Press H for the title screen", screenHeight-60)
+			return
+		}
 
-	if g.gameOver {
-		// Draw game over screen
-		msg := fmt.Sprintf("Game Over! Your score: %d", g.score)
-		x := (screenWidth - len(msg)*7) / 2
-		y := screenHeight / 2
-
-		// Draw text with shadow for better visibility
-		text.Draw(screen, msg, g.face, x+1, y+1, color.Black)
-		text.Draw(screen, msg, g.face, x, y, color.White)
-
-		msg = "Click to play again"
-		x = (screenWidth - len(msg)*7) / 2
-		y += 30
-		text.Draw(screen, msg, g.face, x+1, y+1, color.Black)
-		text.Draw(screen, msg, g.face, x, y, color.White)
+		g.drawCentered(screen, "Click to start the Bee Catching Game!", screenHeight/2)
+		g.drawCentered(screen, "Press H for high scores", screenHeight/2+30)
 		return
 	}
 
-	// Draw bees
-	for _, bee := range g.bees {
-		if !bee.visible {
-			continue
+	if g.ctx.GameOver {
+		if g.enteringScore {
+			g.drawCentered(screen, "New high score!", screenHeight/2-40)
+			g.drawCentered(screen, fmt.Sprintf("Enter your initials: %s", g.initialsInput), screenHeight/2-10)
+			g.drawCentered(screen, "Press Enter to confirm", screenHeight/2+20)
+			return
 		}
 
-		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(bee.x, bee.y)
-
-		if bee.isHornets {
-			screen.DrawImage(hornetsImage, op)
-		} else {
-			screen.DrawImage(beeImage, op)
-		}
+		g.drawCentered(screen, fmt.Sprintf("Game Over! Your score: %d", g.ctx.Score), screenHeight/2-100)
+		g.drawHighScores(screen, screenHeight/2-60)
+		g.drawCentered(screen, "Click to play again", screenHeight-40)
+		return
 	}
 
-	// Draw lightning effect
-	if g.lightningEffect {
-		for i := 0; i < 10; i++ {
-			x1 := rand.Intn(screenWidth)
-			y1 := 0
-			x2 := rand.Intn(screenWidth)
-			y2 := screenHeight
-			ebitenutil.DrawLine(screen, float64(x1), float64(y1), float64(x2), float64(y2), color.RGBA{255, 255, 0, 192})
-		}
+	if g.levelCardTicks > 0 {
+		// Between-level card: pause on the new level's name before the
+		// next wave's bees start spawning.
+		g.drawCentered(screen, g.levelCardText, screenHeight/2)
+		return
 	}
 
-	// Draw score and time with shadow for better visibility against forest background
-	scoreText := fmt.Sprintf("Score: %d", g.score)
-	text.Draw(screen, scoreText, g.face, 11, 21, color.Black)
-	text.Draw(screen, scoreText, g.face, 10, 20, color.White)
-
-	timeText := fmt.Sprintf("Time: %d", g.remainingTime)
-	text.Draw(screen, timeText, g.face, screenWidth-99, 21, color.Black)
-	text.Draw(screen, timeText, g.face, screenWidth-100, 20, color.White)
-
-	hornetsText := fmt.Sprintf("Hornets: %d/3", g.hornetsClicked)
-	text.Draw(screen, hornetsText, g.face, 11, 41, color.Black)
-	text.Draw(screen, hornetsText, g.face, 10, 40, color.White)
+	for _, s := range g.draw {
+		s.Draw(g.ctx, screen)
+	}
 }
 
 // Layout implements ebiten.Game's Layout
@@ -363,9 +398,6 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func main() {
-	// Set random seed
-	rand.Seed(time.Now().UnixNano())
-
 	// Set up the game
 	ebiten.SetWindowSize(screenWidth, screenHeight)
 	ebiten.SetWindowTitle("Bee Catching Game")
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/kf-pineapple/aws-q/app/bee/component"
+)
+
+// frameSize is the width and height in pixels of a single animation frame
+// within a sprite sheet.
+const frameSize = 32
+
+// animTicksPerFrame is how many Update ticks an animation frame is held
+// for before advancing to the next one.
+const animTicksPerFrame = 8
+
+var (
+	beeSheet    *component.SpriteSheet
+	hornetSheet *component.SpriteSheet
+	queenSheet  *component.SpriteSheet
+	batSheet    *component.SpriteSheet
+	forestImage *ebiten.Image
+)
+
+// loadSpriteSheets loads and slices every creature's sprite sheet.
+func loadSpriteSheets() {
+	beeSheet = component.NewSpriteSheet(loadImage("image/bee_sheet.png"), frameSize, frameSize)
+	hornetSheet = component.NewSpriteSheet(loadImage("image/hornet_sheet.png"), frameSize, frameSize)
+	queenSheet = component.NewSpriteSheet(loadImage("image/queen_sheet.png"), frameSize, frameSize)
+	batSheet = component.NewSpriteSheet(loadImage("image/bat_sheet.png"), frameSize, frameSize)
+}
+
+// loadForestImage loads the forest background.
+func loadForestImage() {
+	forestImage = loadImage("image/forest.jpg")
+}
+
+// loadImage decodes path into an *ebiten.Image, exiting the program on
+// failure since missing art assets mean the game can't run.
+func loadImage(path string) *ebiten.Image {
+	f, err := ebitenutil.OpenFile(path)
+	if err != nil {
+		log.Fatalf("failed to open image %s: %v", path, err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		log.Fatalf("failed to decode image %s: %v", path, err)
+	}
+	return ebiten.NewImageFromImage(img)
+}
@@ -0,0 +1,53 @@
+package system
+
+import "github.com/kf-pineapple/aws-q/app/bee/component"
+
+// BeeType implements per-variant behavior for a spawned creature: custom
+// movement AI (e.g. the Queen fleeing the cursor, the Bat's sinusoidal
+// path) in place of MovementSystem's default wall bounce, and a custom
+// reaction when clicked in place of ClickSystem's default scoring.
+type BeeType interface {
+	Update(e component.Entity, ctx *Context)
+	OnClick(e component.Entity, ctx *Context)
+}
+
+// BehaviorSystem drives entities that have been assigned a BeeType,
+// letting SpawnSystem opt specific entities out of the generic movement
+// and click handling without MovementSystem/ClickSystem knowing about
+// every enemy variant.
+type BehaviorSystem struct {
+	types map[component.Entity]BeeType
+}
+
+// NewBehaviorSystem returns an empty BehaviorSystem.
+func NewBehaviorSystem() *BehaviorSystem {
+	return &BehaviorSystem{types: make(map[component.Entity]BeeType)}
+}
+
+// Assign gives e custom behavior t.
+func (s *BehaviorSystem) Assign(e component.Entity, t BeeType) {
+	s.types[e] = t
+}
+
+// Get returns the BeeType assigned to e, if any.
+func (s *BehaviorSystem) Get(e component.Entity) (BeeType, bool) {
+	t, ok := s.types[e]
+	return t, ok
+}
+
+// Has reports whether e has custom behavior, so MovementSystem can skip it.
+func (s *BehaviorSystem) Has(e component.Entity) bool {
+	_, ok := s.types[e]
+	return ok
+}
+
+func (s *BehaviorSystem) Update(ctx *Context) error {
+	for e, t := range s.types {
+		if _, alive := ctx.World.Positions[e]; !alive {
+			delete(s.types, e)
+			continue
+		}
+		t.Update(e, ctx)
+	}
+	return nil
+}
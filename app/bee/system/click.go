@@ -0,0 +1,80 @@
+package system
+
+import (
+	"github.com/kf-pineapple/aws-q/app/bee/input"
+)
+
+// ClickSystem consumes this tick's queued PointerDownEvents and scores (or
+// stings) one clickable entity under each one. Where several entities
+// overlap the point, which of them is picked is not defined. Because every Down event is resolved independently, several simultaneous
+// touches can each pop a different bee in the same tick.
+type ClickSystem struct {
+	behavior *BehaviorSystem
+}
+
+// NewClickSystem returns a ClickSystem. behavior may be nil if no entities
+// ever get a custom click reaction.
+func NewClickSystem(behavior *BehaviorSystem) *ClickSystem {
+	return &ClickSystem{behavior: behavior}
+}
+
+func (s *ClickSystem) Update(ctx *Context) error {
+	for _, ev := range ctx.Events {
+		down, ok := ev.(input.PointerDownEvent)
+		if !ok {
+			continue
+		}
+		s.handleClick(ctx, down.X, down.Y)
+	}
+	return nil
+}
+
+func (s *ClickSystem) handleClick(ctx *Context, cx, cy float64) {
+	w := ctx.World
+
+	for e := range w.Clickables {
+		pos, ok := w.Positions[e]
+		if !ok {
+			continue
+		}
+		hb := w.Hitboxes[e]
+
+		if cx < pos.X || cx > pos.X+float64(hb.Width) ||
+			cy < pos.Y || cy > pos.Y+float64(hb.Height) {
+			continue
+		}
+
+		if s.behavior != nil {
+			if t, ok := s.behavior.Get(e); ok {
+				t.OnClick(e, ctx)
+				w.Destroy(e)
+				return
+			}
+		}
+
+		if w.Tags[e] == "hornet" {
+			ctx.HornetsClicked++
+			ctx.LightningEffect = true
+			ctx.LightningTimer = 30
+			if ctx.OnSting != nil {
+				ctx.OnSting()
+			}
+			if ctx.HornetsClicked >= 3 {
+				ctx.GameOver = true
+				if ctx.OnGameOver != nil {
+					ctx.OnGameOver()
+				}
+			}
+		} else {
+			if score, ok := w.Scores[e]; ok {
+				ctx.Score += score.Value
+			}
+			if ctx.OnCatch != nil {
+				ctx.OnCatch()
+			}
+		}
+
+		w.Destroy(e)
+		return
+	}
+}
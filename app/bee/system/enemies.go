@@ -0,0 +1,106 @@
+package system
+
+import (
+	"math"
+
+	"github.com/kf-pineapple/aws-q/app/bee/component"
+)
+
+// QueenBeeType makes an entity flee the cursor instead of bouncing off
+// walls. It only ever appears once per level (SpawnSystem enforces that).
+type QueenBeeType struct {
+	Speed float64
+}
+
+func (q *QueenBeeType) Update(e component.Entity, ctx *Context) {
+	pos, ok := ctx.World.Positions[e]
+	if !ok {
+		return
+	}
+
+	dx := pos.X - float64(ctx.CursorX)
+	dy := pos.Y - float64(ctx.CursorY)
+	dist := math.Hypot(dx, dy)
+	if dist == 0 {
+		dist = 1
+	}
+
+	pos.X += dx / dist * q.Speed
+	pos.Y += dy / dist * q.Speed
+
+	width, height := 0, 0
+	if hb, ok := ctx.World.Hitboxes[e]; ok {
+		width, height = hb.Width, hb.Height
+	}
+	pos.X = clamp(pos.X, 0, float64(ctx.ScreenWidth-width))
+	pos.Y = clamp(pos.Y, 0, float64(ctx.ScreenHeight-height))
+
+	if sprite, ok := ctx.World.Sprites[e]; ok {
+		sprite.FacingLeft = dx < 0
+	}
+}
+
+func (q *QueenBeeType) OnClick(e component.Entity, ctx *Context) {
+	if score, ok := ctx.World.Scores[e]; ok {
+		ctx.Score += score.Value
+	}
+	if ctx.OnCatch != nil {
+		ctx.OnCatch()
+	}
+}
+
+// BatBeeType flies a sinusoidal path: constant horizontal speed, vertical
+// position driven by component.Wave.
+type BatBeeType struct {
+	SpeedX float64
+}
+
+func (b *BatBeeType) Update(e component.Entity, ctx *Context) {
+	pos, ok := ctx.World.Positions[e]
+	if !ok {
+		return
+	}
+	wave, ok := ctx.World.Waves[e]
+	if !ok {
+		return
+	}
+
+	pos.X += b.SpeedX
+	wave.Phase += wave.Speed
+	pos.Y = wave.BaseY + wave.Amplitude*math.Sin(wave.Phase)
+
+	width := 0
+	if hb, ok := ctx.World.Hitboxes[e]; ok {
+		width = hb.Width
+	}
+	// Wrap around the screen instead of bouncing, so the Bat keeps
+	// sweeping its sine wave rather than reversing it.
+	if pos.X < -float64(width) {
+		pos.X = float64(ctx.ScreenWidth)
+	} else if pos.X > float64(ctx.ScreenWidth) {
+		pos.X = -float64(width)
+	}
+
+	if sprite, ok := ctx.World.Sprites[e]; ok {
+		sprite.FacingLeft = b.SpeedX < 0
+	}
+}
+
+func (b *BatBeeType) OnClick(e component.Entity, ctx *Context) {
+	if score, ok := ctx.World.Scores[e]; ok {
+		ctx.Score += score.Value
+	}
+	if ctx.OnCatch != nil {
+		ctx.OnCatch()
+	}
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
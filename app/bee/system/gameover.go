@@ -0,0 +1,23 @@
+package system
+
+// GameOverSystem ends the round once the clock runs out. The three-hornet
+// loss condition is handled by ClickSystem, since it fires at the moment
+// of the click rather than once per tick.
+type GameOverSystem struct{}
+
+// NewGameOverSystem returns a GameOverSystem.
+func NewGameOverSystem() *GameOverSystem {
+	return &GameOverSystem{}
+}
+
+func (s *GameOverSystem) Update(ctx *Context) error {
+	if ctx.GameOver || ctx.RemainingTime > 0 {
+		return nil
+	}
+
+	ctx.GameOver = true
+	if ctx.OnGameOver != nil {
+		ctx.OnGameOver()
+	}
+	return nil
+}
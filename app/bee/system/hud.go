@@ -0,0 +1,31 @@
+package system
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// HUDSystem draws the score, remaining time and hornet counter, each with
+// a drop shadow for legibility against the forest background.
+type HUDSystem struct{}
+
+// NewHUDSystem returns a HUDSystem.
+func NewHUDSystem() *HUDSystem {
+	return &HUDSystem{}
+}
+
+func (s *HUDSystem) Draw(ctx *Context, screen *ebiten.Image) {
+	drawShadowed(screen, ctx, fmt.Sprintf("Score: %d", ctx.Score), 10, 20)
+	drawShadowed(screen, ctx, fmt.Sprintf("Time: %d", ctx.RemainingTime), ctx.ScreenWidth-100, 20)
+	drawShadowed(screen, ctx, fmt.Sprintf("Hornets: %d/3", ctx.HornetsClicked), 10, 40)
+}
+
+// drawShadowed draws msg twice, offset by one pixel in black then in white
+// on top, a cheap approximation of an outlined/shadowed label.
+func drawShadowed(screen *ebiten.Image, ctx *Context, msg string, x, y int) {
+	text.Draw(screen, msg, ctx.Face, x+1, y+1, color.Black)
+	text.Draw(screen, msg, ctx.Face, x, y, color.White)
+}
@@ -0,0 +1,50 @@
+package system
+
+import (
+	"image/color"
+	"math/rand/v2"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// LightningEffectSystem counts down the screen-flash shown when a hornet
+// is clicked, and draws the bolts while it's active.
+type LightningEffectSystem struct {
+	BoltCount int
+}
+
+// NewLightningEffectSystem returns a LightningEffectSystem that draws 10
+// bolts per frame while active.
+func NewLightningEffectSystem() *LightningEffectSystem {
+	return &LightningEffectSystem{BoltCount: 10}
+}
+
+func (s *LightningEffectSystem) Update(ctx *Context) error {
+	if !ctx.LightningEffect {
+		return nil
+	}
+
+	ctx.LightningTimer--
+	if ctx.LightningTimer <= 0 {
+		ctx.LightningEffect = false
+	}
+	return nil
+}
+
+// Draw rolls bolt X's from the package-level rand source, not ctx.Rand:
+// Draw runs on ebiten's variable-rate render cadence rather than the fixed
+// 60 TPS Update runs at, so drawing from the seeded Rand would advance it
+// a nondeterministic number of times per Update tick and desync every
+// later spawn/velocity roll from the replay seed.
+func (s *LightningEffectSystem) Draw(ctx *Context, screen *ebiten.Image) {
+	if !ctx.LightningEffect {
+		return
+	}
+
+	for i := 0; i < s.BoltCount; i++ {
+		x1 := rand.IntN(ctx.ScreenWidth)
+		x2 := rand.IntN(ctx.ScreenWidth)
+		ebitenutil.DrawLine(screen, float64(x1), 0, float64(x2), float64(ctx.ScreenHeight), color.RGBA{255, 255, 0, 192})
+	}
+}
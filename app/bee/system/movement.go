@@ -0,0 +1,52 @@
+package system
+
+// MovementSystem moves every entity with a Position and Velocity, bouncing
+// it off the screen edges. Entities with a custom BeeType (assigned via
+// BehaviorSystem) are not moved here — BehaviorSystem moves them instead.
+// Every entity's sprite animation, custom AI or not, is advanced here.
+type MovementSystem struct {
+	behavior *BehaviorSystem
+}
+
+// NewMovementSystem returns a MovementSystem. behavior may be nil if no
+// entities ever get custom movement AI.
+func NewMovementSystem(behavior *BehaviorSystem) *MovementSystem {
+	return &MovementSystem{behavior: behavior}
+}
+
+func (s *MovementSystem) Update(ctx *Context) error {
+	w := ctx.World
+	for e, pos := range w.Positions {
+		hasCustomAI := s.behavior != nil && s.behavior.Has(e)
+
+		if vel, ok := w.Velocities[e]; ok && !hasCustomAI {
+			pos.X += vel.X
+			pos.Y += vel.Y
+
+			width, height := 0, 0
+			if hb, ok := w.Hitboxes[e]; ok {
+				width, height = hb.Width, hb.Height
+			}
+
+			if pos.X <= 0 || pos.X >= float64(ctx.ScreenWidth-width) {
+				vel.X = -vel.X
+			}
+			if pos.Y <= 0 || pos.Y >= float64(ctx.ScreenHeight-height) {
+				vel.Y = -vel.Y
+			}
+
+			if sprite, ok := w.Sprites[e]; ok && vel.X != 0 {
+				sprite.FacingLeft = vel.X < 0
+			}
+		}
+
+		if sprite, ok := w.Sprites[e]; ok {
+			sprite.Tick++
+			if sprite.TicksPerFrame > 0 && sprite.Tick >= sprite.TicksPerFrame {
+				sprite.Tick = 0
+				sprite.Frame = (sprite.Frame + 1) % sprite.Sheet.FrameCount()
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package system
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// RenderSystem draws every entity with a Position and Sprite, selecting
+// row 1 of the sheet (vs. row 0) and flipping horizontally for sprites
+// currently facing left.
+type RenderSystem struct{}
+
+// NewRenderSystem returns a RenderSystem.
+func NewRenderSystem() *RenderSystem {
+	return &RenderSystem{}
+}
+
+func (s *RenderSystem) Draw(ctx *Context, screen *ebiten.Image) {
+	w := ctx.World
+	for e, sprite := range w.Sprites {
+		pos, ok := w.Positions[e]
+		if !ok {
+			continue
+		}
+
+		row := 0
+		if sprite.FacingLeft {
+			row = 1
+		}
+		frame := sprite.Sheet.At(sprite.Frame, row)
+
+		op := &ebiten.DrawImageOptions{}
+		if sprite.FacingLeft {
+			op.GeoM.Scale(-1, 1)
+			op.GeoM.Translate(float64(sprite.Sheet.FrameW), 0)
+		}
+		op.GeoM.Translate(pos.X, pos.Y)
+		screen.DrawImage(frame, op)
+	}
+}
@@ -0,0 +1,174 @@
+package system
+
+import (
+	"github.com/kf-pineapple/aws-q/app/bee/component"
+)
+
+// SpawnSystem periodically creates new entities, up to MaxBees at a time.
+// Every tunable is exported so a Level can retune the difficulty curve
+// between rounds (Game.applyLevel does this).
+type SpawnSystem struct {
+	BeeSheet    *component.SpriteSheet
+	HornetSheet *component.SpriteSheet
+	QueenSheet  *component.SpriteSheet
+	BatSheet    *component.SpriteSheet
+
+	SpawnChance     float64
+	MaxBees         int
+	HornetChance    float64
+	HighSpeedChance float64
+	BatChance       float64
+	QueenScore      int
+
+	// Enabled lists which kinds ("bee", "hornet", "bat", "queen") the
+	// current level allows SpawnSystem to create.
+	Enabled map[string]bool
+
+	TicksPerFrame int
+
+	behavior *BehaviorSystem
+
+	queenSpawned bool
+}
+
+// NewSpawnSystem returns a SpawnSystem using the original game's tuning: a
+// 5% per-tick spawn chance up to 10 bees, 20% of which are hornets and 10%
+// of which are high speed. Game.applyLevel overwrites these per level.
+func NewSpawnSystem(beeSheet, hornetSheet, queenSheet, batSheet *component.SpriteSheet, ticksPerFrame int, behavior *BehaviorSystem) *SpawnSystem {
+	return &SpawnSystem{
+		BeeSheet:        beeSheet,
+		HornetSheet:     hornetSheet,
+		QueenSheet:      queenSheet,
+		BatSheet:        batSheet,
+		SpawnChance:     0.05,
+		MaxBees:         10,
+		HornetChance:    0.2,
+		HighSpeedChance: 0.1,
+		BatChance:       0,
+		QueenScore:      10,
+		Enabled:         map[string]bool{"bee": true, "hornet": true},
+		TicksPerFrame:   ticksPerFrame,
+		behavior:        behavior,
+	}
+}
+
+// ResetForLevel clears the once-per-level Queen spawn flag. Call it every
+// time a new level/round begins.
+func (s *SpawnSystem) ResetForLevel() {
+	s.queenSpawned = false
+}
+
+func (s *SpawnSystem) Update(ctx *Context) error {
+	w := ctx.World
+	if len(w.Tags) >= s.MaxBees {
+		return nil
+	}
+	if ctx.Rand.Float64() >= s.SpawnChance {
+		return nil
+	}
+
+	switch {
+	case s.Enabled["queen"] && !s.queenSpawned && ctx.Rand.Float64() < 0.1:
+		s.queenSpawned = true
+		s.spawnQueen(ctx)
+	case s.Enabled["bat"] && ctx.Rand.Float64() < s.BatChance:
+		s.spawnBat(ctx)
+	default:
+		s.spawnBee(ctx)
+	}
+
+	return nil
+}
+
+func (s *SpawnSystem) spawnBee(ctx *Context) {
+	w := ctx.World
+
+	isHornet := s.Enabled["hornet"] && ctx.Rand.Float64() < s.HornetChance
+	isHighSpeed := ctx.Rand.Float64() < s.HighSpeedChance
+
+	sheet := s.BeeSheet
+	tag := "bee"
+	if isHornet {
+		sheet = s.HornetSheet
+		tag = "hornet"
+	}
+
+	speedBase := 2.0
+	if isHighSpeed {
+		speedBase = 5.0
+	}
+
+	width, height := sheet.FrameW, sheet.FrameH
+
+	e := w.NewEntity()
+	w.Tags[e] = tag
+	w.Positions[e] = &component.Position{
+		X: float64(ctx.Rand.IntN(ctx.ScreenWidth - width)),
+		Y: float64(ctx.Rand.IntN(ctx.ScreenHeight - height)),
+	}
+	w.Velocities[e] = &component.Velocity{
+		X: (ctx.Rand.Float64()*2 - 1) * speedBase,
+		Y: (ctx.Rand.Float64()*2 - 1) * speedBase,
+	}
+	w.Hitboxes[e] = &component.Hitbox{Width: width, Height: height}
+	w.Sprites[e] = &component.Sprite{Sheet: sheet, TicksPerFrame: s.TicksPerFrame}
+	w.Clickables[e] = &component.Clickable{}
+
+	switch {
+	case isHornet:
+		w.Scores[e] = &component.Score{Value: 0}
+	case isHighSpeed:
+		w.Scores[e] = &component.Score{Value: 3}
+	default:
+		w.Scores[e] = &component.Score{Value: 1}
+	}
+}
+
+// spawnQueen creates the once-per-level Queen: worth QueenScore points and,
+// via BehaviorSystem, fleeing the cursor instead of bouncing off walls.
+func (s *SpawnSystem) spawnQueen(ctx *Context) {
+	w := ctx.World
+	sheet := s.QueenSheet
+	width, height := sheet.FrameW, sheet.FrameH
+
+	e := w.NewEntity()
+	w.Tags[e] = "queen"
+	w.Positions[e] = &component.Position{
+		X: float64(ctx.Rand.IntN(ctx.ScreenWidth - width)),
+		Y: float64(ctx.Rand.IntN(ctx.ScreenHeight - height)),
+	}
+	w.Hitboxes[e] = &component.Hitbox{Width: width, Height: height}
+	w.Sprites[e] = &component.Sprite{Sheet: sheet, TicksPerFrame: s.TicksPerFrame}
+	w.Clickables[e] = &component.Clickable{}
+	w.Scores[e] = &component.Score{Value: s.QueenScore}
+
+	if s.behavior != nil {
+		s.behavior.Assign(e, &QueenBeeType{Speed: 2.5})
+	}
+}
+
+// spawnBat creates a Bat flying a sinusoidal path across the screen.
+func (s *SpawnSystem) spawnBat(ctx *Context) {
+	w := ctx.World
+	sheet := s.BatSheet
+	width, height := sheet.FrameW, sheet.FrameH
+
+	baseY := float64(ctx.Rand.IntN(ctx.ScreenHeight - height))
+	speedX := (ctx.Rand.Float64()*2 - 1) * 3
+
+	e := w.NewEntity()
+	w.Tags[e] = "bat"
+	w.Positions[e] = &component.Position{
+		X: float64(ctx.Rand.IntN(ctx.ScreenWidth - width)),
+		Y: baseY,
+	}
+	w.Hitboxes[e] = &component.Hitbox{Width: width, Height: height}
+	w.Sprites[e] = &component.Sprite{Sheet: sheet, TicksPerFrame: s.TicksPerFrame}
+	w.Clickables[e] = &component.Clickable{}
+	w.Scores[e] = &component.Score{Value: 2}
+	w.Waves[e] = &component.Wave{BaseY: baseY, Amplitude: 40, Phase: 0, Speed: 0.08}
+
+	if s.behavior != nil {
+		s.behavior.Assign(e, &BatBeeType{SpeedX: speedX})
+	}
+}
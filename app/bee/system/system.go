@@ -0,0 +1,73 @@
+// Package system implements the behavior that used to live inline in
+// Game.Update/Draw, split into small, independently testable systems that
+// operate on a component.World plus the shared Context.
+package system
+
+import (
+	"math/rand/v2"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font"
+
+	"github.com/kf-pineapple/aws-q/app/bee/component"
+	"github.com/kf-pineapple/aws-q/app/bee/input"
+)
+
+// UpdateSystem advances game logic by one tick.
+type UpdateSystem interface {
+	Update(ctx *Context) error
+}
+
+// DrawSystem renders onto the screen. Draw systems run in registration
+// order, after the background. They do not run at all while Game draws
+// one of its own full screens (start screen, level card, game-over screen).
+type DrawSystem interface {
+	Draw(ctx *Context, screen *ebiten.Image)
+}
+
+// Context carries the state systems need that doesn't belong to any one
+// entity: screen size, round state, and hooks back into Game for the
+// effects (audio, scoring) that systems shouldn't own directly.
+type Context struct {
+	World *component.World
+
+	ScreenWidth  int
+	ScreenHeight int
+
+	GameOver bool
+
+	RemainingTime int
+
+	Score          int
+	HornetsClicked int
+
+	LightningEffect bool
+	LightningTimer  int
+
+	CursorX, CursorY int
+
+	// Events holds this tick's pointer events (mouse, touch, gamepad),
+	// queued by Game from input.Source.Poll. ClickSystem consumes them
+	// instead of polling ebiten directly, so several simultaneous touches
+	// can each pop a bee in the same tick.
+	Events []input.Event
+
+	// Rand is the game's seeded source of randomness, so spawn and
+	// velocity rolls are reproducible from a seed. Replay determinism
+	// covers only those rolls: ClickSystem and RenderSystem iterate
+	// World's component maps, whose order Go randomizes per run, so click
+	// resolution and draw order are not reproduced by seed alone, and
+	// LightningEffectSystem.Draw deliberately uses its own unseeded
+	// source rather than Rand, since Draw runs on ebiten's variable frame
+	// cadence and would otherwise desync every later roll.
+	Rand *rand.Rand
+
+	Face font.Face
+
+	// OnCatch, OnSting and OnGameOver let Game hook sound effects and
+	// other side effects into system-driven events without systems
+	// importing package main.
+	OnCatch    func()
+	OnSting    func()
+	OnGameOver func()
+}